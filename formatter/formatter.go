@@ -0,0 +1,39 @@
+// Package formatter renders an average-age Result as plain text, JSON, or
+// HTML, chosen by name or picked for the requesting client.
+package formatter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnsupportedFormat is returned by New when name does not map to a
+// known Formatter.
+var ErrUnsupportedFormat = errors.New("formatter: unsupported format")
+
+// Result is the data a Formatter renders.
+type Result struct {
+	AverageAge float64 `json:"averageAge"`
+}
+
+// Formatter renders a Result as a string along with the MIME type it
+// should be served as.
+type Formatter interface {
+	Format(r Result) (string, error)
+	ContentType() string
+}
+
+// New returns the Formatter registered under name ("text", "json", or
+// "html").
+func New(name string) (Formatter, error) {
+	switch name {
+	case "text":
+		return TextFormatter{}, nil
+	case "json":
+		return JSONFormatter{}, nil
+	case "html":
+		return HTMLFormatter{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, name)
+	}
+}
@@ -0,0 +1,50 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	for _, name := range []string{"text", "json", "html"} {
+		if _, err := New(name); err != nil {
+			t.Errorf("New(%q) returned error: %v", name, err)
+		}
+	}
+}
+
+func TestNewUnsupportedFormat(t *testing.T) {
+	if _, err := New("xml"); err == nil {
+		t.Fatal("New did not return an error for an unsupported format")
+	}
+}
+
+func TestTextFormatter(t *testing.T) {
+	got, err := TextFormatter{}.Format(Result{AverageAge: 27.5})
+	if err != nil {
+		t.Fatalf("Format returned error: %v", err)
+	}
+	if want := "Average age of active users: 27.5\n"; got != want {
+		t.Errorf("Format = %q, want %q", got, want)
+	}
+}
+
+func TestJSONFormatter(t *testing.T) {
+	got, err := JSONFormatter{}.Format(Result{AverageAge: 27.5})
+	if err != nil {
+		t.Fatalf("Format returned error: %v", err)
+	}
+	if want := `{"averageAge":27.5}`; got != want {
+		t.Errorf("Format = %q, want %q", got, want)
+	}
+}
+
+func TestHTMLFormatter(t *testing.T) {
+	got, err := HTMLFormatter{}.Format(Result{AverageAge: 27.5})
+	if err != nil {
+		t.Fatalf("Format returned error: %v", err)
+	}
+	if want := "27.5"; !strings.Contains(got, want) {
+		t.Errorf("Format = %q, want it to contain %q", got, want)
+	}
+}
@@ -0,0 +1,33 @@
+package formatter
+
+import (
+	"html/template"
+	"strings"
+)
+
+// htmlTemplate renders a Result as a minimal HTML page, for browser
+// clients.
+var htmlTemplate = template.Must(template.New("result").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Average Age</title></head>
+<body>
+<p>Average age of active users: {{printf "%.1f" .AverageAge}}</p>
+</body>
+</html>
+`))
+
+// HTMLFormatter renders a Result as an HTML page, suitable for browser
+// clients.
+type HTMLFormatter struct{}
+
+// Format implements Formatter.
+func (HTMLFormatter) Format(r Result) (string, error) {
+	var sb strings.Builder
+	if err := htmlTemplate.Execute(&sb, r); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// ContentType implements Formatter.
+func (HTMLFormatter) ContentType() string { return "text/html; charset=utf-8" }
@@ -0,0 +1,18 @@
+package formatter
+
+import "encoding/json"
+
+// JSONFormatter renders a Result as a JSON object.
+type JSONFormatter struct{}
+
+// Format implements Formatter.
+func (JSONFormatter) Format(r Result) (string, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ContentType implements Formatter.
+func (JSONFormatter) ContentType() string { return "application/json" }
@@ -0,0 +1,15 @@
+package formatter
+
+import "fmt"
+
+// TextFormatter renders a Result as a plain-text line, suitable for CLI
+// clients such as curl, Wget, HTTPie, or Go-http-client.
+type TextFormatter struct{}
+
+// Format implements Formatter.
+func (TextFormatter) Format(r Result) (string, error) {
+	return fmt.Sprintf("Average age of active users: %.1f\n", r.AverageAge), nil
+}
+
+// ContentType implements Formatter.
+func (TextFormatter) ContentType() string { return "text/plain; charset=utf-8" }
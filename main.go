@@ -0,0 +1,73 @@
+package main
+
+import (
+	"embed"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/JoaquinCampo/agent-debugger/formatter"
+	"github.com/JoaquinCampo/agent-debugger/stats"
+	"github.com/JoaquinCampo/agent-debugger/user"
+	"github.com/JoaquinCampo/agent-debugger/userstore"
+)
+
+//go:embed data/users.json
+var embeddedData embed.FS
+
+const defaultDataFile = "data/users.json"
+
+// loadUsers returns the users found at path, or the embedded demo dataset
+// when path is empty.
+func loadUsers(path string) ([]user.User, error) {
+	if path == "" {
+		f, err := embeddedData.Open(defaultDataFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return userstore.LoadJSON(f)
+	}
+
+	store, err := userstore.New(path)
+	if err != nil {
+		return nil, err
+	}
+	return store.Load(path)
+}
+
+// calculateAverageAge returns the mean age of the active users in users,
+// or 0 if there are none.
+func calculateAverageAge(users []user.User) float64 {
+	avg, err := stats.UserData(users).ActiveOnly().Mean()
+	if err != nil {
+		return 0
+	}
+	return avg
+}
+
+func main() {
+	input := flag.String("input", "", "path to a JSON, YAML, or CSV user dataset (defaults to the embedded demo data)")
+	format := flag.String("format", "text", "output format for CLI mode: text, json, or html")
+	addr := flag.String("addr", "", "if set, serve /average over HTTP on this address instead of printing once and exiting")
+	flag.Parse()
+
+	users, err := loadUsers(*input)
+	if err != nil {
+		log.Fatalf("loading users: %v", err)
+	}
+
+	if *addr != "" {
+		log.Fatal(serve(*addr, users))
+	}
+
+	f, err := formatter.New(*format)
+	if err != nil {
+		log.Fatalf("selecting formatter: %v", err)
+	}
+	out, err := f.Format(formatter.Result{AverageAge: calculateAverageAge(users)})
+	if err != nil {
+		log.Fatalf("formatting result: %v", err)
+	}
+	fmt.Print(out)
+}
@@ -0,0 +1,51 @@
+// Package metrics computes rolling statistics over streaming data.
+package metrics
+
+// AvgBuff is a fixed-size ring buffer of (value, weight) pairs that
+// maintains a running weighted average over the values currently held.
+// Once full, each Push overwrites the oldest slot.
+type AvgBuff struct {
+	vals      []float64
+	weights   []float64
+	pos       int
+	filled    int
+	sumVal    float64
+	sumWeight float64
+}
+
+// NewAvgBuff returns an AvgBuff holding up to size (value, weight) pairs.
+func NewAvgBuff(size int) *AvgBuff {
+	return &AvgBuff{
+		vals:    make([]float64, size),
+		weights: make([]float64, size),
+	}
+}
+
+// Push records val with the given weight, evicting the oldest pair if the
+// buffer is already full.
+func (b *AvgBuff) Push(val, weight float64) {
+	if len(b.vals) == 0 {
+		return
+	}
+	if b.filled == len(b.vals) {
+		b.sumVal -= b.vals[b.pos] * b.weights[b.pos]
+		b.sumWeight -= b.weights[b.pos]
+	} else {
+		b.filled++
+	}
+
+	b.vals[b.pos] = val
+	b.weights[b.pos] = weight
+	b.sumVal += val * weight
+	b.sumWeight += weight
+	b.pos = (b.pos + 1) % len(b.vals)
+}
+
+// Avg returns the weighted average of the pairs currently held, or 0 if
+// the buffer is empty or every weight held is 0.
+func (b *AvgBuff) Avg() float64 {
+	if b.sumWeight == 0 {
+		return 0
+	}
+	return b.sumVal / b.sumWeight
+}
@@ -0,0 +1,42 @@
+package metrics
+
+import "testing"
+
+func TestAvgBuffWithinCapacity(t *testing.T) {
+	b := NewAvgBuff(4)
+	b.Push(10, 1)
+	b.Push(20, 1)
+	if got, want := b.Avg(), 15.0; got != want {
+		t.Errorf("Avg = %v, want %v", got, want)
+	}
+}
+
+func TestAvgBuffEvictsOldestWhenFull(t *testing.T) {
+	b := NewAvgBuff(2)
+	b.Push(10, 1)
+	b.Push(20, 1)
+	b.Push(30, 1) // evicts the 10
+
+	if got, want := b.Avg(), 25.0; got != want {
+		t.Errorf("Avg = %v, want %v", got, want)
+	}
+}
+
+func TestAvgBuffWeights(t *testing.T) {
+	b := NewAvgBuff(3)
+	b.Push(10, 1)
+	b.Push(20, 0)
+	b.Push(30, 1)
+
+	// sum(val*weight)/sum(weight) = (10*1 + 20*0 + 30*1) / (1+0+1)
+	if got, want := b.Avg(), 20.0; got != want {
+		t.Errorf("Avg = %v, want %v", got, want)
+	}
+}
+
+func TestAvgBuffEmpty(t *testing.T) {
+	b := NewAvgBuff(3)
+	if got, want := b.Avg(), 0.0; got != want {
+		t.Errorf("Avg = %v, want %v", got, want)
+	}
+}
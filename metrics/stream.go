@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"github.com/JoaquinCampo/agent-debugger/user"
+	"github.com/JoaquinCampo/agent-debugger/userage"
+)
+
+// WeightFunc computes the weight a user's age contributes to a rolling
+// average.
+type WeightFunc func(user.User) float64
+
+// DefaultWeight gives active users weight 1 and inactive users weight 0,
+// matching calculateAverageAge's historical active-only semantics.
+func DefaultWeight(u user.User) float64 {
+	if u.Active {
+		return 1
+	}
+	return 0
+}
+
+// AverageAgeStream consumes users from ch and emits the rolling weighted
+// average age over the last window users as each one arrives, weighting
+// active users 1 and inactive users 0. The returned channel is closed once
+// ch is closed and drained.
+func AverageAgeStream(ch <-chan user.User, window int) <-chan float64 {
+	return AverageAgeStreamWithWeight(ch, window, DefaultWeight)
+}
+
+// AverageAgeStreamWithWeight is AverageAgeStream with a caller-supplied
+// weighting policy in place of DefaultWeight.
+func AverageAgeStreamWithWeight(ch <-chan user.User, window int, weight WeightFunc) <-chan float64 {
+	out := make(chan float64)
+	go func() {
+		defer close(out)
+		buf := NewAvgBuff(window)
+		for u := range ch {
+			age := float64(userage.AgeAt(u.DOB, userage.Now()))
+			buf.Push(age, weight(u))
+			out <- buf.Avg()
+		}
+	}()
+	return out
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+	"github.com/JoaquinCampo/agent-debugger/userage"
+)
+
+func TestAverageAgeStream(t *testing.T) {
+	now := time.Date(2026, time.July, 29, 0, 0, 0, 0, time.UTC)
+	orig := userage.Now
+	userage.Now = func() time.Time { return now }
+	defer func() { userage.Now = orig }()
+
+	birthday := func(age int) time.Time {
+		return time.Date(now.Year()-age, now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}
+
+	ch := make(chan user.User)
+	out := AverageAgeStream(ch, 2)
+
+	go func() {
+		ch <- user.User{Name: "Alice", DOB: birthday(30), Active: true}
+		ch <- user.User{Name: "Bob", DOB: birthday(40), Active: true}
+		ch <- user.User{Name: "Diana", DOB: birthday(20), Active: false}
+		close(ch)
+	}()
+
+	want := []float64{30, 35, 40}
+	for i, w := range want {
+		got, ok := <-out
+		if !ok {
+			t.Fatalf("stream closed early at index %d", i)
+		}
+		if got != w {
+			t.Errorf("avg[%d] = %v, want %v", i, got, w)
+		}
+	}
+	if _, ok := <-out; ok {
+		t.Fatal("stream did not close after input was drained")
+	}
+}
+
+func TestAverageAgeStreamWithWeightCustomPolicy(t *testing.T) {
+	now := time.Date(2026, time.July, 29, 0, 0, 0, 0, time.UTC)
+	orig := userage.Now
+	userage.Now = func() time.Time { return now }
+	defer func() { userage.Now = orig }()
+
+	birthday := func(age int) time.Time {
+		return time.Date(now.Year()-age, now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}
+
+	allUsers := func(user.User) float64 { return 1 }
+
+	ch := make(chan user.User, 1)
+	ch <- user.User{Name: "Diana", DOB: birthday(20), Active: false}
+	close(ch)
+
+	out := AverageAgeStreamWithWeight(ch, 2, allUsers)
+	if got, want := <-out, 20.0; got != want {
+		t.Errorf("Avg = %v, want %v", got, want)
+	}
+}
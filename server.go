@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/JoaquinCampo/agent-debugger/formatter"
+	"github.com/JoaquinCampo/agent-debugger/user"
+	"github.com/JoaquinCampo/agent-debugger/useragent"
+)
+
+// cliProducts are User-Agent products known to be non-browser HTTP
+// clients; requests from them get a plain-text response instead of HTML.
+var cliProducts = map[string]bool{
+	"curl":           true,
+	"Wget":           true,
+	"HTTPie":         true,
+	"Go-http-client": true,
+}
+
+// serve runs an HTTP server on addr exposing /average, which reports the
+// mean age of the active users in users.
+func serve(addr string, users []user.User) error {
+	http.HandleFunc("/average", averageHandler(users))
+	return http.ListenAndServe(addr, nil)
+}
+
+// averageHandler renders the average age of users, choosing a Formatter
+// from the request's User-Agent: known CLI clients get text/plain, and
+// everything else (browsers) gets text/html.
+func averageHandler(users []user.User) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		result := formatter.Result{AverageAge: calculateAverageAge(users)}
+		f := formatterForUserAgent(r.UserAgent())
+
+		out, err := f.Format(result)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", f.ContentType())
+		fmt.Fprint(w, out)
+	}
+}
+
+// formatterForUserAgent picks TextFormatter for known CLI clients (curl,
+// Wget, HTTPie, Go-http-client) and HTMLFormatter for everything else.
+func formatterForUserAgent(raw string) formatter.Formatter {
+	ua := useragent.Parse(raw)
+	if cliProducts[ua.Product] {
+		return formatter.TextFormatter{}
+	}
+	return formatter.HTMLFormatter{}
+}
@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestFormatterForUserAgent(t *testing.T) {
+	cases := []struct {
+		name string
+		ua   string
+		want string
+	}{
+		{"curl", "curl/7.68.0", "text/plain; charset=utf-8"},
+		{"wget", "Wget/1.21.2", "text/plain; charset=utf-8"},
+		{"httpie", "HTTPie/3.2.1", "text/plain; charset=utf-8"},
+		{"go-http-client", "Go-http-client/1.1", "text/plain; charset=utf-8"},
+		{"browser", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36", "text/html; charset=utf-8"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := formatterForUserAgent(c.ua).ContentType()
+			if got != c.want {
+				t.Errorf("formatterForUserAgent(%q).ContentType() = %q, want %q", c.ua, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Package stats computes descriptive statistics over collections of
+// user.User, modeled after the API shape of montanaflynn/stats: free
+// functions over []float64-like inputs, a sentinel error for empty input,
+// and a thin wrapper type that carries the common case as method receivers.
+package stats
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+	"github.com/JoaquinCampo/agent-debugger/userage"
+)
+
+// ErrEmptyInput is returned by any function in this package when asked to
+// summarize an empty collection.
+var ErrEmptyInput = errors.New("stats: empty input")
+
+// UserData wraps a []user.User so the statistics below can be called as
+// methods, e.g. UserData(users).Mean().
+type UserData []user.User
+
+// ages returns the age of every user in d, computed from DOB as of now, as
+// a float64 slice.
+func (d UserData) ages() []float64 {
+	now := userage.Now()
+	out := make([]float64, len(d))
+	for i, u := range d {
+		out[i] = float64(userage.AgeAt(u.DOB, now))
+	}
+	return out
+}
+
+// Mean returns the average age across d.
+func (d UserData) Mean() (float64, error) { return Mean(d.ages()) }
+
+// Median returns the median age across d.
+func (d UserData) Median() (float64, error) { return Median(d.ages()) }
+
+// Mode returns the age(s) that occur most frequently in d.
+func (d UserData) Mode() ([]float64, error) { return Mode(d.ages()) }
+
+// Variance returns the population variance of ages across d.
+func (d UserData) Variance() (float64, error) { return Variance(d.ages()) }
+
+// StdDev returns the population standard deviation of ages across d.
+func (d UserData) StdDev() (float64, error) { return StdDev(d.ages()) }
+
+// Percentile returns the p-th percentile (0-100) of ages across d.
+func (d UserData) Percentile(p float64) (float64, error) { return Percentile(d.ages(), p) }
+
+// ActiveOnly returns the subset of d whose Active field is true.
+func (d UserData) ActiveOnly() UserData {
+	out := make(UserData, 0, len(d))
+	for _, u := range d {
+		if u.Active {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// ByAgeRange returns the subset of d whose age, computed from DOB as of
+// now, falls within [min, max] inclusive.
+func (d UserData) ByAgeRange(min, max int) UserData {
+	now := userage.Now()
+	out := make(UserData, 0, len(d))
+	for _, u := range d {
+		age := userage.AgeAt(u.DOB, now)
+		if age >= min && age <= max {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// Mean returns the arithmetic mean of data.
+func Mean(data []float64) (float64, error) {
+	if len(data) == 0 {
+		return 0, ErrEmptyInput
+	}
+	var sum float64
+	for _, v := range data {
+		sum += v
+	}
+	return sum / float64(len(data)), nil
+}
+
+// Median returns the middle value of data once sorted, averaging the two
+// central values when len(data) is even.
+func Median(data []float64) (float64, error) {
+	if len(data) == 0 {
+		return 0, ErrEmptyInput
+	}
+	sorted := sortedCopy(data)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2, nil
+	}
+	return sorted[mid], nil
+}
+
+// Mode returns the value(s) that occur most frequently in data. All values
+// tied for the highest frequency are returned, sorted ascending. The running
+// maximum count is tracked in a single pass over the sorted data so that a
+// later, less-frequent value can never overwrite the tied leaders found
+// earlier.
+func Mode(data []float64) ([]float64, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyInput
+	}
+	sorted := sortedCopy(data)
+
+	var modes []float64
+	maxCount := 0
+	count := 0
+	for i, v := range sorted {
+		if i == 0 || v != sorted[i-1] {
+			count = 1
+		} else {
+			count++
+		}
+
+		switch {
+		case count > maxCount:
+			maxCount = count
+			modes = []float64{v}
+		case count == maxCount:
+			if len(modes) == 0 || modes[len(modes)-1] != v {
+				modes = append(modes, v)
+			}
+		}
+	}
+	return modes, nil
+}
+
+// Variance returns the population variance of data.
+func Variance(data []float64) (float64, error) {
+	if len(data) == 0 {
+		return 0, ErrEmptyInput
+	}
+	mean, _ := Mean(data)
+	var sumSq float64
+	for _, v := range data {
+		d := v - mean
+		sumSq += d * d
+	}
+	return sumSq / float64(len(data)), nil
+}
+
+// StdDev returns the population standard deviation of data.
+func StdDev(data []float64) (float64, error) {
+	variance, err := Variance(data)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Percentile returns the p-th percentile (0-100) of data using linear
+// interpolation between the closest ranks.
+func Percentile(data []float64, p float64) (float64, error) {
+	if len(data) == 0 {
+		return 0, ErrEmptyInput
+	}
+	sorted := sortedCopy(data)
+	if len(sorted) == 1 {
+		return sorted[0], nil
+	}
+
+	rank := (p / 100) * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > len(sorted)-1 {
+		hi = len(sorted) - 1
+	}
+	if lo == hi {
+		return sorted[lo], nil
+	}
+	frac := rank - float64(lo)
+	return sorted[lo] + frac*(sorted[hi]-sorted[lo]), nil
+}
+
+// sortedCopy returns an ascending-sorted copy of data, leaving data itself
+// untouched.
+func sortedCopy(data []float64) []float64 {
+	out := make([]float64, len(data))
+	copy(out, data)
+	sort.Float64s(out)
+	return out
+}
@@ -0,0 +1,148 @@
+package stats
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JoaquinCampo/agent-debugger/userage"
+)
+
+// fixedNow pins userage.Now for the duration of a test so ages computed
+// from DOB are deterministic, restoring the original clock on cleanup.
+func fixedNow(t *testing.T, now time.Time) {
+	t.Helper()
+	orig := userage.Now
+	userage.Now = func() time.Time { return now }
+	t.Cleanup(func() { userage.Now = orig })
+}
+
+func birthday(now time.Time, age int) time.Time {
+	return time.Date(now.Year()-age, now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
+
+func TestMean(t *testing.T) {
+	got, err := Mean([]float64{30, 25, 35, 32})
+	if err != nil {
+		t.Fatalf("Mean returned error: %v", err)
+	}
+	if want := 30.5; got != want {
+		t.Errorf("Mean = %v, want %v", got, want)
+	}
+}
+
+func TestMeanEmptyInput(t *testing.T) {
+	if _, err := Mean(nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("Mean(nil) error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	cases := []struct {
+		name string
+		data []float64
+		want float64
+	}{
+		{"odd", []float64{1, 3, 2}, 2},
+		{"even", []float64{1, 2, 3, 4}, 2.5},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Median(c.data)
+			if err != nil {
+				t.Fatalf("Median returned error: %v", err)
+			}
+			if got != c.want {
+				t.Errorf("Median = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestModeTiesAfterLowerFrequencyTail(t *testing.T) {
+	// 30 and 35 are each tied at the highest frequency (2), with a
+	// lower-frequency value (40) appearing after them. A buggy
+	// single-pass implementation that resets on any count change would
+	// lose the 30 tie; this guards against that.
+	data := []float64{30, 30, 35, 35, 40}
+	got, err := Mode(data)
+	if err != nil {
+		t.Fatalf("Mode returned error: %v", err)
+	}
+	want := []float64{30, 35}
+	if !equalFloat64s(got, want) {
+		t.Errorf("Mode = %v, want %v", got, want)
+	}
+}
+
+func TestModeSingleValue(t *testing.T) {
+	got, err := Mode([]float64{5})
+	if err != nil {
+		t.Fatalf("Mode returned error: %v", err)
+	}
+	if want := []float64{5}; !equalFloat64s(got, want) {
+		t.Errorf("Mode = %v, want %v", got, want)
+	}
+}
+
+func TestModeEmptyInput(t *testing.T) {
+	if _, err := Mode(nil); !errors.Is(err, ErrEmptyInput) {
+		t.Errorf("Mode(nil) error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	data := []float64{10, 20, 30, 40}
+	got, err := Percentile(data, 50)
+	if err != nil {
+		t.Fatalf("Percentile returned error: %v", err)
+	}
+	if want := 25.0; got != want {
+		t.Errorf("Percentile(50) = %v, want %v", got, want)
+	}
+}
+
+func TestUserDataActiveOnlyAndMean(t *testing.T) {
+	now := time.Date(2026, time.July, 29, 0, 0, 0, 0, time.UTC)
+	fixedNow(t, now)
+
+	users := UserData{
+		{Name: "Alice", DOB: birthday(now, 30), Active: true},
+		{Name: "Bob", DOB: birthday(now, 25), Active: true},
+		{Name: "Diana", DOB: birthday(now, 28), Active: false},
+	}
+	got, err := users.ActiveOnly().Mean()
+	if err != nil {
+		t.Fatalf("Mean returned error: %v", err)
+	}
+	if want := 27.5; got != want {
+		t.Errorf("Mean = %v, want %v", got, want)
+	}
+}
+
+func TestUserDataByAgeRange(t *testing.T) {
+	now := time.Date(2026, time.July, 29, 0, 0, 0, 0, time.UTC)
+	fixedNow(t, now)
+
+	users := UserData{
+		{Name: "Alice", DOB: birthday(now, 30)},
+		{Name: "Bob", DOB: birthday(now, 25)},
+		{Name: "Charlie", DOB: birthday(now, 35)},
+	}
+	got := users.ByAgeRange(26, 35)
+	if len(got) != 2 {
+		t.Fatalf("ByAgeRange returned %d users, want 2", len(got))
+	}
+}
+
+func equalFloat64s(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,15 @@
+// Package user defines the domain model shared across the stats, userstore,
+// and userquery packages.
+package user
+
+import "time"
+
+// User represents a single record in the user dataset. Struct tags cover
+// the JSON, YAML, and CSV encodings used by the userstore package. Age is
+// not stored directly; compute it from DOB with userage.AgeAt so it stays
+// correct as time passes.
+type User struct {
+	Name   string    `json:"name" yaml:"name" csv:"name"`
+	DOB    time.Time `json:"dob" yaml:"dob" csv:"dob"`
+	Active bool      `json:"active" yaml:"active" csv:"active"`
+}
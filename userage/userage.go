@@ -0,0 +1,24 @@
+// Package userage computes ages in whole years from a date of birth,
+// relative to an explicit point in time so results stay correct as time
+// passes instead of being frozen at the moment they were written.
+package userage
+
+import "time"
+
+// Now is the clock used by callers that need "the current time" without
+// threading it through every call explicitly. Tests may reassign it to a
+// fixed value for deterministic results.
+var Now = time.Now
+
+// AgeAt returns the whole number of years elapsed between dob and now. If
+// now's month/day has not yet reached dob's month/day in now's year, the
+// birthday hasn't occurred yet and the year count is reduced by one.
+func AgeAt(dob, now time.Time) int {
+	years := now.Year() - dob.Year()
+
+	birthdayThisYear := time.Date(now.Year(), dob.Month(), dob.Day(), 0, 0, 0, 0, now.Location())
+	if now.Before(birthdayThisYear) {
+		years--
+	}
+	return years
+}
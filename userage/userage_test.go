@@ -0,0 +1,27 @@
+package userage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAgeAt(t *testing.T) {
+	now := time.Date(2026, time.July, 29, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		dob  time.Time
+		want int
+	}{
+		{"birthday before today", time.Date(2000, time.June, 1, 0, 0, 0, 0, time.UTC), 26},
+		{"birthday is today", time.Date(2000, time.July, 29, 0, 0, 0, 0, time.UTC), 26},
+		{"birthday after today", time.Date(2000, time.August, 1, 0, 0, 0, 0, time.UTC), 25},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := AgeAt(c.dob, now); got != c.want {
+				t.Errorf("AgeAt(%v, %v) = %d, want %d", c.dob, now, got, c.want)
+			}
+		})
+	}
+}
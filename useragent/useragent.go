@@ -0,0 +1,30 @@
+// Package useragent parses HTTP User-Agent header values into their
+// product, version, and comment parts.
+package useragent
+
+import "regexp"
+
+// UserAgent is the product/version/comment decomposition of a User-Agent
+// header value, e.g. "curl/7.68.0" parses to Product "curl", Version
+// "7.68.0", and an empty Comment.
+type UserAgent struct {
+	Product string
+	Version string
+	Comment string
+}
+
+// productPattern captures the leading "product/version" token and an
+// optional "(comment)" that follows it, as used by curl, Wget, HTTPie,
+// Go-http-client, and browser User-Agent strings alike.
+var productPattern = regexp.MustCompile(`^(\S+?)/(\S+)(?:\s+\(([^)]*)\))?`)
+
+// Parse decomposes raw into its product, version, and comment parts. If
+// raw does not match the expected "product/version" form, it is returned
+// unchanged as Product with an empty Version and Comment.
+func Parse(raw string) UserAgent {
+	m := productPattern.FindStringSubmatch(raw)
+	if m == nil {
+		return UserAgent{Product: raw}
+	}
+	return UserAgent{Product: m[1], Version: m[2], Comment: m[3]}
+}
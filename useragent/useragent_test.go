@@ -0,0 +1,49 @@
+package useragent
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  string
+		want UserAgent
+	}{
+		{
+			name: "curl",
+			raw:  "curl/7.68.0",
+			want: UserAgent{Product: "curl", Version: "7.68.0"},
+		},
+		{
+			name: "wget",
+			raw:  "Wget/1.21.2",
+			want: UserAgent{Product: "Wget", Version: "1.21.2"},
+		},
+		{
+			name: "httpie",
+			raw:  "HTTPie/3.2.1",
+			want: UserAgent{Product: "HTTPie", Version: "3.2.1"},
+		},
+		{
+			name: "go-http-client",
+			raw:  "Go-http-client/1.1",
+			want: UserAgent{Product: "Go-http-client", Version: "1.1"},
+		},
+		{
+			name: "browser with comment",
+			raw:  "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36",
+			want: UserAgent{Product: "Mozilla", Version: "5.0", Comment: "X11; Linux x86_64"},
+		},
+		{
+			name: "unrecognized form",
+			raw:  "some-custom-client",
+			want: UserAgent{Product: "some-custom-client"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Parse(c.raw); got != c.want {
+				t.Errorf("Parse(%q) = %+v, want %+v", c.raw, got, c.want)
+			}
+		})
+	}
+}
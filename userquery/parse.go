@@ -0,0 +1,168 @@
+package userquery
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+	"github.com/JoaquinCampo/agent-debugger/userage"
+)
+
+// predicate reports whether u satisfies a parsed filter expression.
+type predicate func(u user.User) (bool, error)
+
+var exprPattern = regexp.MustCompile(`^\$\.users\[\?\((.*)\)\]$`)
+
+// parsePredicate parses a "$.users[?( ... )]" expression into a predicate
+// evaluating the boolean condition inside the filter.
+func parsePredicate(expr string) (predicate, error) {
+	expr = strings.TrimSpace(expr)
+	m := exprPattern.FindStringSubmatch(expr)
+	if m == nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidExpr, expr)
+	}
+	return parseOr(m[1])
+}
+
+// parseOr splits cond on top-level "||" and ORs the resulting clauses.
+func parseOr(cond string) (predicate, error) {
+	clauses := strings.Split(cond, "||")
+	preds := make([]predicate, len(clauses))
+	for i, c := range clauses {
+		p, err := parseAnd(c)
+		if err != nil {
+			return nil, err
+		}
+		preds[i] = p
+	}
+	return func(u user.User) (bool, error) {
+		for _, p := range preds {
+			ok, err := p(u)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}, nil
+}
+
+// parseAnd splits cond on top-level "&&" and ANDs the resulting atoms.
+func parseAnd(cond string) (predicate, error) {
+	atoms := strings.Split(cond, "&&")
+	preds := make([]predicate, len(atoms))
+	for i, a := range atoms {
+		p, err := parseComparison(a)
+		if err != nil {
+			return nil, err
+		}
+		preds[i] = p
+	}
+	return func(u user.User) (bool, error) {
+		for _, p := range preds {
+			ok, err := p(u)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+		return true, nil
+	}, nil
+}
+
+// comparisonPattern captures "@.field OP value", trying two-character
+// operators before their one-character prefixes.
+var comparisonPattern = regexp.MustCompile(`^@\.(\w+)\s*(==|!=|<=|>=|<|>)\s*(.+)$`)
+
+func parseComparison(atom string) (predicate, error) {
+	atom = strings.TrimSpace(atom)
+	m := comparisonPattern.FindStringSubmatch(atom)
+	if m == nil {
+		return nil, fmt.Errorf("%w: bad comparison %q", ErrInvalidExpr, atom)
+	}
+	field, op, rawValue := m[1], m[2], strings.TrimSpace(m[3])
+
+	switch field {
+	case "age":
+		want, err := strconv.ParseFloat(rawValue, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: age comparison value %q: %v", ErrInvalidExpr, rawValue, err)
+		}
+		return func(u user.User) (bool, error) {
+			age := userage.AgeAt(u.DOB, userage.Now())
+			return compareNumber(float64(age), op, want)
+		}, nil
+	case "active":
+		want, err := strconv.ParseBool(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("%w: active comparison value %q: %v", ErrInvalidExpr, rawValue, err)
+		}
+		return func(u user.User) (bool, error) {
+			return compareBool(u.Active, op, want)
+		}, nil
+	case "name":
+		want, err := unquote(rawValue)
+		if err != nil {
+			return nil, err
+		}
+		return func(u user.User) (bool, error) {
+			return compareString(u.Name, op, want)
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidExpr, field)
+	}
+}
+
+func unquote(raw string) (string, error) {
+	if len(raw) >= 2 && (raw[0] == '\'' || raw[0] == '"') && raw[len(raw)-1] == raw[0] {
+		return raw[1 : len(raw)-1], nil
+	}
+	return "", fmt.Errorf("%w: string value %q must be quoted", ErrInvalidExpr, raw)
+}
+
+func compareNumber(got float64, op string, want float64) (bool, error) {
+	switch op {
+	case "==":
+		return got == want, nil
+	case "!=":
+		return got != want, nil
+	case "<":
+		return got < want, nil
+	case "<=":
+		return got <= want, nil
+	case ">":
+		return got > want, nil
+	case ">=":
+		return got >= want, nil
+	default:
+		return false, fmt.Errorf("%w: unsupported operator %q", ErrInvalidExpr, op)
+	}
+}
+
+func compareBool(got bool, op string, want bool) (bool, error) {
+	switch op {
+	case "==":
+		return got == want, nil
+	case "!=":
+		return got != want, nil
+	default:
+		return false, fmt.Errorf("%w: operator %q is not valid for bool fields", ErrInvalidExpr, op)
+	}
+}
+
+func compareString(got, op, want string) (bool, error) {
+	switch op {
+	case "==":
+		return got == want, nil
+	case "!=":
+		return got != want, nil
+	default:
+		return false, fmt.Errorf("%w: operator %q is not valid for string fields", ErrInvalidExpr, op)
+	}
+}
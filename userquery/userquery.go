@@ -0,0 +1,161 @@
+// Package userquery lets callers select and patch user.User collections
+// using a small subset of JSONPath filter expressions, e.g.:
+//
+//	$.users[?(@.age >= 30 && @.active == true)]
+//
+// Supported fields are Name (string), Age (number, computed from DOB as of
+// userage.Now()), and Active (bool). Age is compared with ==, !=, <, <=, >,
+// >=; Name and Active accept only == and !=. Comparisons are combined with
+// && (higher precedence) and || (lower precedence).
+package userquery
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+)
+
+// ErrInvalidExpr is returned when an expression does not match the
+// supported "$.users[?( ... )]" filter syntax.
+var ErrInvalidExpr = errors.New("userquery: invalid expression")
+
+// Patch operations understood by Apply.
+const (
+	OpReplace = "replace"
+	OpRemove  = "remove"
+	OpAdd     = "add"
+)
+
+// Patch describes one mutation to apply to a selected subset of users.
+// Path uses the same filter syntax as Query and selects which users the
+// patch applies to; it is ignored for OpAdd.
+type Patch struct {
+	Op    string
+	Path  string
+	Field string      // field name for OpReplace: "name", "dob", or "active"
+	Value interface{} // new field value for OpReplace; a user.User for OpAdd
+}
+
+// Query returns the users in users matching expr.
+func Query(users []user.User, expr string) ([]user.User, error) {
+	idx, err := matchIndices(users, expr)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]user.User, len(idx))
+	for i, id := range idx {
+		out[i] = users[id]
+	}
+	return out, nil
+}
+
+// Apply returns a copy of users with patches applied in order.
+func Apply(users []user.User, patches []Patch) ([]user.User, error) {
+	result := make([]user.User, len(users))
+	copy(result, users)
+
+	for _, p := range patches {
+		var err error
+		switch p.Op {
+		case OpAdd:
+			result, err = applyAdd(result, p)
+		case OpReplace:
+			result, err = applyReplace(result, p)
+		case OpRemove:
+			result, err = applyRemove(result, p)
+		default:
+			err = fmt.Errorf("userquery: unknown patch op %q", p.Op)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func applyAdd(users []user.User, p Patch) ([]user.User, error) {
+	u, ok := p.Value.(user.User)
+	if !ok {
+		return nil, fmt.Errorf("userquery: add patch Value must be a user.User, got %T", p.Value)
+	}
+	return append(users, u), nil
+}
+
+func applyReplace(users []user.User, p Patch) ([]user.User, error) {
+	idx, err := matchIndices(users, p.Path)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range idx {
+		if err := setField(&users[i], p.Field, p.Value); err != nil {
+			return nil, err
+		}
+	}
+	return users, nil
+}
+
+func applyRemove(users []user.User, p Patch) ([]user.User, error) {
+	idx, err := matchIndices(users, p.Path)
+	if err != nil {
+		return nil, err
+	}
+	drop := make(map[int]bool, len(idx))
+	for _, i := range idx {
+		drop[i] = true
+	}
+
+	out := make([]user.User, 0, len(users)-len(drop))
+	for i, u := range users {
+		if !drop[i] {
+			out = append(out, u)
+		}
+	}
+	return out, nil
+}
+
+func setField(u *user.User, field string, value interface{}) error {
+	switch field {
+	case "name":
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("userquery: field %q expects a string, got %T", field, value)
+		}
+		u.Name = v
+	case "dob":
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("userquery: field %q expects a time.Time, got %T", field, value)
+		}
+		u.DOB = v
+	case "active":
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("userquery: field %q expects a bool, got %T", field, value)
+		}
+		u.Active = v
+	default:
+		return fmt.Errorf("userquery: unknown field %q", field)
+	}
+	return nil
+}
+
+// matchIndices returns the indices of users matching expr.
+func matchIndices(users []user.User, expr string) ([]int, error) {
+	pred, err := parsePredicate(expr)
+	if err != nil {
+		return nil, err
+	}
+	var idx []int
+	for i, u := range users {
+		ok, err := pred(u)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			idx = append(idx, i)
+		}
+	}
+	return idx, nil
+}
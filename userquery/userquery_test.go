@@ -0,0 +1,118 @@
+package userquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+	"github.com/JoaquinCampo/agent-debugger/userage"
+)
+
+// now is the fixed clock sample's ages are computed against; see init.
+var now = time.Date(2026, time.July, 29, 0, 0, 0, 0, time.UTC)
+
+// birthday returns the DOB of someone who turns age on now.
+func birthday(age int) time.Time {
+	return time.Date(now.Year()-age, now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
+
+func init() {
+	userage.Now = func() time.Time { return now }
+}
+
+var sample = []user.User{
+	{Name: "Alice", DOB: birthday(30), Active: true},
+	{Name: "Bob", DOB: birthday(25), Active: true},
+	{Name: "Charlie", DOB: birthday(35), Active: true},
+	{Name: "Diana", DOB: birthday(28), Active: false},
+}
+
+func TestQueryAndAnd(t *testing.T) {
+	got, err := Query(sample, "$.users[?(@.age >= 30 && @.active == true)]")
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Query returned %d users, want 2", len(got))
+	}
+}
+
+func TestQueryOr(t *testing.T) {
+	got, err := Query(sample, "$.users[?(@.name == 'Diana' || @.age > 30)]")
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Query returned %d users, want 2", len(got))
+	}
+}
+
+func TestQueryInvalidExpr(t *testing.T) {
+	if _, err := Query(sample, "not a path"); err == nil {
+		t.Fatal("Query did not return an error for a malformed expression")
+	}
+}
+
+func TestApplyReplaceDeactivatesOverAge(t *testing.T) {
+	patches := []Patch{
+		{Op: OpReplace, Path: "$.users[?(@.age > 30)]", Field: "active", Value: false},
+	}
+	got, err := Apply(sample, patches)
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	for _, u := range got {
+		if userage.AgeAt(u.DOB, now) > 30 && u.Active {
+			t.Errorf("user %+v should have been deactivated", u)
+		}
+	}
+}
+
+func TestApplyRemove(t *testing.T) {
+	patches := []Patch{
+		{Op: OpRemove, Path: "$.users[?(@.active == false)]"},
+	}
+	got, err := Apply(sample, patches)
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	for _, u := range got {
+		if !u.Active {
+			t.Errorf("inactive user %+v should have been removed", u)
+		}
+	}
+	if len(got) != len(sample)-1 {
+		t.Fatalf("got %d users, want %d", len(got), len(sample)-1)
+	}
+}
+
+func TestApplyAdd(t *testing.T) {
+	patches := []Patch{
+		{Op: OpAdd, Value: user.User{Name: "Frank", DOB: birthday(40), Active: true}},
+	}
+	got, err := Apply(sample, patches)
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	if len(got) != len(sample)+1 {
+		t.Fatalf("got %d users, want %d", len(got), len(sample)+1)
+	}
+	if got[len(got)-1].Name != "Frank" {
+		t.Errorf("last user = %+v, want Frank", got[len(got)-1])
+	}
+}
+
+func TestApplyDoesNotMutateInput(t *testing.T) {
+	original := append([]user.User(nil), sample...)
+	_, err := Apply(sample, []Patch{
+		{Op: OpReplace, Path: "$.users[?(@.age > 0)]", Field: "active", Value: false},
+	})
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	for i := range sample {
+		if sample[i] != original[i] {
+			t.Fatalf("Apply mutated its input slice at index %d", i)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package userstore
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+)
+
+var csvHeader = []string{"name", "dob", "active"}
+
+// csvDOBLayout is the date format used for the dob column: a plain
+// calendar date, with no time-of-day or zone to round-trip.
+const csvDOBLayout = "2006-01-02"
+
+// LoadCSV reads users from r in "name,dob,active" CSV form, expecting a
+// header row matching csvHeader.
+func LoadCSV(r io.Reader) ([]user.User, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	users := make([]user.User, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		if len(row) != 3 {
+			return nil, fmt.Errorf("userstore: csv row %q: want 3 fields, got %d", row, len(row))
+		}
+		dob, err := time.Parse(csvDOBLayout, row[1])
+		if err != nil {
+			return nil, fmt.Errorf("userstore: csv row %q: invalid dob: %w", row, err)
+		}
+		active, err := strconv.ParseBool(row[2])
+		if err != nil {
+			return nil, fmt.Errorf("userstore: csv row %q: invalid active: %w", row, err)
+		}
+		users = append(users, user.User{Name: row[0], DOB: dob, Active: active})
+	}
+	return users, nil
+}
+
+// SaveCSV writes users to w as CSV with a "name,dob,active" header.
+func SaveCSV(w io.Writer, users []user.User) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, u := range users {
+		row := []string{u.Name, u.DOB.Format(csvDOBLayout), strconv.FormatBool(u.Active)}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
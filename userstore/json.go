@@ -0,0 +1,24 @@
+package userstore
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+)
+
+// LoadJSON reads a JSON array of users from r.
+func LoadJSON(r io.Reader) ([]user.User, error) {
+	var users []user.User
+	if err := json.NewDecoder(r).Decode(&users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// SaveJSON writes users to w as a pretty-printed JSON array.
+func SaveJSON(w io.Writer, users []user.User) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(users)
+}
@@ -0,0 +1,106 @@
+// Package userstore loads and saves []user.User collections in JSON, YAML,
+// or CSV form, chosen by file extension.
+package userstore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+)
+
+// ErrUnsupportedFormat is returned when a path's extension does not map to
+// a known format.
+var ErrUnsupportedFormat = errors.New("userstore: unsupported file format")
+
+// UserStore loads and saves a []user.User collection against a file path.
+type UserStore interface {
+	Load(path string) ([]user.User, error)
+	Save(path string, users []user.User) error
+}
+
+// New returns the UserStore implementation appropriate for path's
+// extension (.json, .yaml/.yml, or .csv).
+func New(path string) (UserStore, error) {
+	switch filepath.Ext(path) {
+	case ".json":
+		return JSONStore{}, nil
+	case ".yaml", ".yml":
+		return YAMLStore{}, nil
+	case ".csv":
+		return CSVStore{}, nil
+	default:
+		return nil, fmt.Errorf("userstore: %s: %w", path, ErrUnsupportedFormat)
+	}
+}
+
+// JSONStore loads and saves users as a JSON array.
+type JSONStore struct{}
+
+// Load reads the users stored as JSON at path.
+func (JSONStore) Load(path string) ([]user.User, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadJSON(f)
+}
+
+// Save writes users as JSON to path.
+func (JSONStore) Save(path string, users []user.User) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return SaveJSON(f, users)
+}
+
+// YAMLStore loads and saves users as a YAML sequence.
+type YAMLStore struct{}
+
+// Load reads the users stored as YAML at path.
+func (YAMLStore) Load(path string) ([]user.User, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadYAML(f)
+}
+
+// Save writes users as YAML to path.
+func (YAMLStore) Save(path string, users []user.User) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return SaveYAML(f, users)
+}
+
+// CSVStore loads and saves users as CSV with a name,dob,active header.
+type CSVStore struct{}
+
+// Load reads the users stored as CSV at path.
+func (CSVStore) Load(path string) ([]user.User, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadCSV(f)
+}
+
+// Save writes users as CSV to path.
+func (CSVStore) Save(path string, users []user.User) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return SaveCSV(f, users)
+}
@@ -0,0 +1,76 @@
+package userstore
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+)
+
+var sample = []user.User{
+	{Name: "Alice", DOB: time.Date(1996, time.March, 4, 0, 0, 0, 0, time.UTC), Active: true},
+	{Name: "Bob", DOB: time.Date(2001, time.November, 19, 0, 0, 0, 0, time.UTC), Active: false},
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := SaveJSON(&buf, sample); err != nil {
+		t.Fatalf("SaveJSON returned error: %v", err)
+	}
+	got, err := LoadJSON(&buf)
+	if err != nil {
+		t.Fatalf("LoadJSON returned error: %v", err)
+	}
+	assertEqual(t, got, sample)
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := SaveYAML(&buf, sample); err != nil {
+		t.Fatalf("SaveYAML returned error: %v", err)
+	}
+	got, err := LoadYAML(&buf)
+	if err != nil {
+		t.Fatalf("LoadYAML returned error: %v", err)
+	}
+	assertEqual(t, got, sample)
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := SaveCSV(&buf, sample); err != nil {
+		t.Fatalf("SaveCSV returned error: %v", err)
+	}
+	got, err := LoadCSV(&buf)
+	if err != nil {
+		t.Fatalf("LoadCSV returned error: %v", err)
+	}
+	assertEqual(t, got, sample)
+}
+
+func TestLoadCSVRejectsMalformedRow(t *testing.T) {
+	r := strings.NewReader("name,dob,active\nAlice,not-a-date,true\n")
+	if _, err := LoadCSV(r); err == nil {
+		t.Fatal("LoadCSV did not return an error for a malformed dob")
+	}
+}
+
+func TestNewUnsupportedFormat(t *testing.T) {
+	if _, err := New("users.txt"); err == nil {
+		t.Fatal("New did not return an error for an unsupported extension")
+	}
+}
+
+func assertEqual(t *testing.T, got, want []user.User) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d users, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("user %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
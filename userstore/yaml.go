@@ -0,0 +1,22 @@
+package userstore
+
+import (
+	"io"
+
+	"github.com/JoaquinCampo/agent-debugger/user"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadYAML reads a YAML sequence of users from r.
+func LoadYAML(r io.Reader) ([]user.User, error) {
+	var users []user.User
+	if err := yaml.NewDecoder(r).Decode(&users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// SaveYAML writes users to w as a YAML sequence.
+func SaveYAML(w io.Writer, users []user.User) error {
+	return yaml.NewEncoder(w).Encode(users)
+}